@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -41,47 +42,87 @@ func colorize(c color, s string) string {
 	return string(c) + s + endColor
 }
 
+// defaultOptions holds the cmp.Option values applied by Equal and NotEqual when a call site
+// doesn't pass its own. Set them with SetDefaultOptions.
+var (
+	defaultOptionsMu sync.RWMutex
+	defaultOptions   []cmp.Option
+)
+
+// SetDefaultOptions sets the cmp.Option values used by Equal and NotEqual when no per-call
+// options are given, e.g. via EqualWith. Like SetReporter, call it before any parallel
+// (t.Parallel) tests start; it's safe to call concurrently with assertions, but a goroutine
+// already mid-assertion may still observe the previous options.
+func SetDefaultOptions(opts ...cmp.Option) {
+	defaultOptionsMu.Lock()
+	defaultOptions = opts
+	defaultOptionsMu.Unlock()
+}
+
+func getDefaultOptions() []cmp.Option {
+	defaultOptionsMu.RLock()
+	defer defaultOptionsMu.RUnlock()
+	return defaultOptions
+}
+
+// isEqual reports whether expected and actual are equal under opts, falling back to
+// reflect.DeepEqual when opts is empty so behavior without options is unchanged.
+func isEqual[T any](expected, actual T, opts []cmp.Option) bool {
+	if len(opts) == 0 {
+		return reflect.DeepEqual(expected, actual)
+	}
+	return cmp.Equal(expected, actual, opts...)
+}
+
 // Equal checks if two objects of any type are equal and reports an error if they are not.
 func Equal[T any](t testing.TB, actual, expected T, msgAndArgs ...any) bool {
 	t.Helper()
-	if reflect.DeepEqual(expected, actual) {
+	file, line := callerInfo()
+	return equalWithOpts(t, actual, expected, getDefaultOptions(), file, line, msgAndArgs...)
+}
+
+// EqualWith checks if two objects of any type are equal under opts and reports an error if
+// they are not. Equality is decided by cmp.Equal(expected, actual, opts...), and the rendered
+// diff uses the same opts so it matches the equality decision. When opts is empty, equality
+// falls back to reflect.DeepEqual while the diff is still produced by cmp.Diff.
+func EqualWith[T any](t testing.TB, actual, expected T, opts []cmp.Option, msgAndArgs ...any) bool {
+	t.Helper()
+	file, line := callerInfo()
+	return equalWithOpts(t, actual, expected, opts, file, line, msgAndArgs...)
+}
+
+func equalWithOpts[T any](t testing.TB, actual, expected T, opts []cmp.Option, file string, line int, msgAndArgs ...any) bool {
+	t.Helper()
+	if isEqual(expected, actual, opts) {
 		return true
 	}
-	result := [][2]string{
-		{"Error", colorize(colorRed, "Not equal")},
-	}
-	extra := messageFromMsgAndArgs(msgAndArgs...)
-	if extra != "" {
-		result = append(result, [2]string{"Message", colorize(colorYellow, extra)})
-	}
-	result = append(result, [2]string{"Expected", colorize(colorGreen, fmt.Sprintf("%#v", expected))})
-	result = append(result, [2]string{"Actual", colorize(colorRed, fmt.Sprintf("%#v", actual))})
-	diffS := diff(expected, actual)
-	if diffS != "" {
-		result = append(result, [2]string{"Diff", diffS})
-	}
-	t.Log(sprintList(result))
-	t.Fail()
+	report(t, Failure{
+		Assertion: "Not equal",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Expected:  fmt.Sprintf("%#v", expected),
+		Actual:    fmt.Sprintf("%#v", actual),
+		Diff:      diff(expected, actual, opts...),
+	})
 	return false
 }
 
 // NotEqual checks if two objects of any type are not equal and reports an error if they are.
 func NotEqual[T any](t testing.TB, actual, expected T, msgAndArgs ...any) bool {
 	t.Helper()
-	if !reflect.DeepEqual(expected, actual) {
+	if !isEqual(expected, actual, getDefaultOptions()) {
 		return true
 	}
-	result := [][2]string{
-		{"Error", "Values should not be equal"},
-	}
-	extra := messageFromMsgAndArgs(msgAndArgs...)
-	if extra != "" {
-		result = append(result, [2]string{"Message", colorize(colorYellow, extra)})
-	}
-	result = append(result, [2]string{"Value", colorize(colorRed, fmt.Sprintf("%#v", expected))})
-	t.Log(sprintList(result))
-	t.Fail()
-
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion:   "Values should not be equal",
+		File:        file,
+		Line:        line,
+		Message:     messageFromMsgAndArgs(msgAndArgs...),
+		Actual:      fmt.Sprintf("%#v", expected),
+		ActualLabel: "Value",
+	})
 	return false
 }
 
@@ -101,7 +142,7 @@ func MustNotEqual[T any](t testing.TB, actual, expected T) {
 	}
 }
 
-func diff[T any](actual, expected T) string {
+func diff[T any](actual, expected T, opts ...cmp.Option) string {
 	et := reflect.TypeOf(expected)
 	at := reflect.TypeOf(actual)
 	if et == nil || at == nil {
@@ -111,7 +152,7 @@ func diff[T any](actual, expected T) string {
 	if ek != reflect.Struct && ek != reflect.Map && ek != reflect.Slice && ek != reflect.Array && ek != reflect.String {
 		return ""
 	}
-	diff := cmp.Diff(expected, actual)
+	diff := cmp.Diff(expected, actual, opts...)
 	return colorizeDiff(diff)
 }
 
@@ -0,0 +1,48 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type timestamped struct {
+	Name string
+	At   int
+}
+
+func TestEqualWith(t *testing.T) {
+	mockT := NewMockT()
+	EqualWith(mockT, timestamped{Name: "a", At: 1}, timestamped{Name: "a", At: 2},
+		[]cmp.Option{cmpopts.IgnoreFields(timestamped{}, "At")})
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	EqualWith(mockT, timestamped{Name: "a", At: 1}, timestamped{Name: "b", At: 1}, nil)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+
+	mockT = NewMockT()
+	EqualWith(mockT, timestamped{Name: "a", At: 1}, timestamped{Name: "b", At: 1}, nil, "custom message")
+	if !mockT.Failed() || mockT.Logs() == "" {
+		t.Fatal("should fail and accept a custom message")
+	}
+}
+
+func TestSetDefaultOptions(t *testing.T) {
+	t.Cleanup(func() { SetDefaultOptions() })
+
+	SetDefaultOptions(cmpopts.IgnoreFields(timestamped{}, "At"))
+
+	mockT := NewMockT()
+	Equal(mockT, timestamped{Name: "a", At: 1}, timestamped{Name: "a", At: 2})
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	NotEqual(mockT, timestamped{Name: "a", At: 1}, timestamped{Name: "a", At: 2})
+	if !mockT.Failed() {
+		t.Fatal("should fail since fields differing only in an ignored field are still equal")
+	}
+}
@@ -0,0 +1,198 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const maxContainerLen = 500
+
+func truncate(s string) string {
+	if len(s) <= maxContainerLen {
+		return s
+	}
+	return s[:maxContainerLen] + "...(truncated)"
+}
+
+// Contains asserts that container holds element. container may be a string (element must
+// also be a string, checked via substring match), a slice or array, or a map (element is
+// checked against its keys).
+func Contains(t testing.TB, container, element any, msgAndArgs ...any) bool {
+	t.Helper()
+	ok, err := containsElement(container, element)
+	if err == nil && ok {
+		return true
+	}
+	file, line := callerInfo()
+	f := Failure{
+		Assertion: "Does not contain element",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Actual:    truncate(fmt.Sprintf("%#v", container)),
+	}
+	if err != nil {
+		f.Extra = append(f.Extra, [2]string{"Reason", colorize(colorRed, err.Error())})
+	}
+	f.Extra = append(f.Extra, [2]string{"Element", colorize(colorGreen, fmt.Sprintf("%#v", element))})
+	report(t, f)
+	return false
+}
+
+func containsElement(container, element any) (bool, error) {
+	containerValue := reflect.ValueOf(container)
+	if !containerValue.IsValid() {
+		return false, fmt.Errorf("nil container")
+	}
+	switch containerValue.Kind() {
+	case reflect.String:
+		elementValue := reflect.ValueOf(element)
+		if elementValue.Kind() != reflect.String {
+			return false, fmt.Errorf("element must be a string to search within a string")
+		}
+		return strings.Contains(containerValue.String(), elementValue.String()), nil
+	case reflect.Map:
+		for _, k := range containerValue.MapKeys() {
+			if reflect.DeepEqual(k.Interface(), element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < containerValue.Len(); i++ {
+			if reflect.DeepEqual(containerValue.Index(i).Interface(), element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("type %T is not a string, slice, array or map", container)
+	}
+}
+
+// Len asserts that container has exactly n elements. container may be a string, slice,
+// array, map or channel.
+func Len(t testing.TB, container any, n int, msgAndArgs ...any) bool {
+	t.Helper()
+	l, ok := getLen(container)
+	if ok && l == n {
+		return true
+	}
+	file, line := callerInfo()
+	actual := fmt.Sprintf("%T has no length", container)
+	if ok {
+		actual = fmt.Sprintf("%d", l)
+	}
+	report(t, Failure{
+		Assertion: "Unexpected length",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Expected:  fmt.Sprintf("%d", n),
+		Actual:    actual,
+	})
+	return false
+}
+
+func getLen(v any) (int, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return rv.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// Subset asserts that every element of sub is present in super, counting duplicates: an
+// element that appears n times in sub must appear at least n times in super.
+func Subset[T comparable](t testing.TB, super, sub []T, msgAndArgs ...any) bool {
+	t.Helper()
+	missing := missingElements(super, sub)
+	if len(missing) == 0 {
+		return true
+	}
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion: "Not a subset",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Extra:     [][2]string{{"Missing", colorize(colorRed, fmt.Sprintf("%#v", missing))}},
+	})
+	return false
+}
+
+func missingElements[T comparable](super, sub []T) []T {
+	counts := make(map[T]int, len(super))
+	for _, v := range super {
+		counts[v]++
+	}
+	var missing []T
+	for _, v := range sub {
+		if counts[v] > 0 {
+			counts[v]--
+			continue
+		}
+		missing = append(missing, v)
+	}
+	return missing
+}
+
+// ElementsMatch asserts that listA and listB contain the same elements, regardless of order
+// or position, counting duplicates.
+func ElementsMatch[T comparable](t testing.TB, listA, listB []T, msgAndArgs ...any) bool {
+	t.Helper()
+	surplus, missing := diffElements(listA, listB)
+	if len(surplus) == 0 && len(missing) == 0 {
+		return true
+	}
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion: "Elements do not match",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Diff:      colorizeDiff(sprintSurplusMissing(surplus, missing)),
+	})
+	return false
+}
+
+func diffElements[T comparable](listA, listB []T) (surplus, missing []T) {
+	remainingB := make(map[T]int, len(listB))
+	for _, v := range listB {
+		remainingB[v]++
+	}
+	for _, v := range listA {
+		if remainingB[v] > 0 {
+			remainingB[v]--
+			continue
+		}
+		surplus = append(surplus, v)
+	}
+	remainingA := make(map[T]int, len(listA))
+	for _, v := range listA {
+		remainingA[v]++
+	}
+	for _, v := range listB {
+		if remainingA[v] > 0 {
+			remainingA[v]--
+			continue
+		}
+		missing = append(missing, v)
+	}
+	return surplus, missing
+}
+
+func sprintSurplusMissing[T any](surplus, missing []T) string {
+	var b strings.Builder
+	for _, v := range surplus {
+		b.WriteString(fmt.Sprintf("-%#v\n", v))
+	}
+	for _, v := range missing {
+		b.WriteString(fmt.Sprintf("+%#v\n", v))
+	}
+	return strings.TrimSpace(b.String())
+}
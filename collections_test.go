@@ -0,0 +1,73 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	mockT := NewMockT()
+	Contains(mockT, "hello world", "world")
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	Contains(mockT, []int{1, 2, 3}, 2)
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	Contains(mockT, map[string]int{"one": 1}, "one")
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	Contains(mockT, []int{1, 2, 3}, 4)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+
+	mockT = NewMockT()
+	Contains(mockT, 1, 2)
+	if !mockT.Failed() {
+		t.Fatal("should fail for unsupported container type")
+	}
+}
+
+func TestLen(t *testing.T) {
+	mockT := NewMockT()
+	Len(mockT, []int{1, 2, 3}, 3)
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	Len(mockT, "abc", 2)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestSubset(t *testing.T) {
+	mockT := NewMockT()
+	Subset(mockT, []int{1, 2, 3}, []int{1, 3})
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	Subset(mockT, []int{1, 2, 3}, []int{1, 4})
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestElementsMatch(t *testing.T) {
+	mockT := NewMockT()
+	ElementsMatch(mockT, []int{1, 2, 3}, []int{3, 2, 1})
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	ElementsMatch(mockT, []int{1, 1, 2}, []int{1, 2, 2})
+	if !mockT.Failed() {
+		t.Fatal("should fail: duplicates must match counts")
+	}
+
+	mockT = NewMockT()
+	ElementsMatch(mockT, []int{1, 2}, []int{1, 2, 3})
+	if !mockT.Failed() {
+		t.Fatal("should fail: different lengths")
+	}
+}
@@ -0,0 +1,109 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// NoError asserts that err is nil.
+func NoError(t testing.TB, err error, msgAndArgs ...any) bool {
+	t.Helper()
+	if err == nil {
+		return true
+	}
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion: "Unexpected error",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Actual:    err.Error(),
+		Extra:     [][2]string{{"Chain", colorize(colorRed, fmt.Sprintf("%+v", err))}},
+	})
+	return false
+}
+
+// Error asserts that err is not nil.
+func Error(t testing.TB, err error, msgAndArgs ...any) bool {
+	t.Helper()
+	if err != nil {
+		return true
+	}
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion: "Expected an error",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+	})
+	return false
+}
+
+// ErrorIs asserts that err or any error in its wrap chain matches target, per errors.Is.
+func ErrorIs(t testing.TB, err, target error, msgAndArgs ...any) bool {
+	t.Helper()
+	if errors.Is(err, target) {
+		return true
+	}
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion:     "Error chain does not match target",
+		File:          file,
+		Line:          line,
+		Message:       messageFromMsgAndArgs(msgAndArgs...),
+		Expected:      fmt.Sprintf("%#v", target),
+		ExpectedLabel: "Target",
+		Actual:        fmt.Sprintf("%#v", err),
+		Extra:         [][2]string{{"Chain", colorize(colorRed, fmt.Sprintf("%+v", err))}},
+	})
+	return false
+}
+
+// ErrorAs asserts that err or any error in its wrap chain can be assigned to *target, per
+// errors.As, and assigns it when it matches.
+func ErrorAs[T error](t testing.TB, err error, target *T, msgAndArgs ...any) bool {
+	t.Helper()
+	if errors.As(err, target) {
+		return true
+	}
+	file, line := callerInfo()
+	var zero T
+	report(t, Failure{
+		Assertion:     "Error chain does not contain target type",
+		File:          file,
+		Line:          line,
+		Message:       messageFromMsgAndArgs(msgAndArgs...),
+		Expected:      fmt.Sprintf("%T", zero),
+		ExpectedLabel: "Target",
+		Actual:        fmt.Sprintf("%#v", err),
+		Extra:         [][2]string{{"Chain", colorize(colorRed, fmt.Sprintf("%+v", err))}},
+	})
+	return false
+}
+
+// ErrorContains asserts that err is not nil and its message contains substr.
+func ErrorContains(t testing.TB, err error, substr string, msgAndArgs ...any) bool {
+	t.Helper()
+	if err != nil && strings.Contains(err.Error(), substr) {
+		return true
+	}
+	file, line := callerInfo()
+	f := Failure{
+		Assertion:     "Error message does not contain substring",
+		File:          file,
+		Line:          line,
+		Message:       messageFromMsgAndArgs(msgAndArgs...),
+		Expected:      substr,
+		ExpectedLabel: "Substr",
+	}
+	if err == nil {
+		f.Actual = "<nil>"
+	} else {
+		f.Actual = err.Error()
+		f.Extra = [][2]string{{"Chain", colorize(colorRed, fmt.Sprintf("%+v", err))}}
+	}
+	report(t, f)
+	return false
+}
@@ -0,0 +1,88 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type wrappedError struct {
+	msg string
+}
+
+func (e *wrappedError) Error() string { return e.msg }
+
+func TestNoError(t *testing.T) {
+	mockT := NewMockT()
+	NoError(mockT, nil)
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	NoError(mockT, anError)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestError(t *testing.T) {
+	mockT := NewMockT()
+	Error(mockT, anError)
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	Error(mockT, nil)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	wrapped := fmt.Errorf("wrapping: %w", anError)
+
+	mockT := NewMockT()
+	ErrorIs(mockT, wrapped, anError)
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	ErrorIs(mockT, errors.New("other"), anError)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	target := &wrappedError{msg: "boom"}
+	wrapped := fmt.Errorf("wrapping: %w", target)
+
+	mockT := NewMockT()
+	var got *wrappedError
+	ErrorAs(mockT, wrapped, &got)
+	mockT.AssertFailed(t, "")
+	Equal(t, got, target)
+
+	mockT = NewMockT()
+	var other *wrappedError
+	ErrorAs(mockT, anError, &other)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestErrorContains(t *testing.T) {
+	mockT := NewMockT()
+	ErrorContains(mockT, errors.New("connection refused"), "refused")
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	ErrorContains(mockT, errors.New("connection refused"), "timeout")
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+
+	mockT = NewMockT()
+	ErrorContains(mockT, nil, "timeout")
+	if !mockT.Failed() || !strings.Contains(mockT.Logs(), "<nil>") {
+		t.Fatal("should fail mentioning nil error")
+	}
+}
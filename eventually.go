@@ -0,0 +1,186 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// scratchT is a scratch testing.TB used to run assertions against a polled value without
+// failing the real test on every intermediate attempt. Only the log from the last attempt
+// is ever surfaced.
+type scratchT struct {
+	*testing.T
+	logs   []string
+	failed bool
+}
+
+func newScratchT() *scratchT {
+	return &scratchT{T: &testing.T{}}
+}
+
+func (s *scratchT) Helper() {}
+
+func (s *scratchT) Log(args ...any) {
+	s.logs = append(s.logs, fmt.Sprint(args...))
+}
+
+func (s *scratchT) Logf(format string, args ...any) {
+	s.Log(fmt.Sprintf(format, args...))
+}
+
+func (s *scratchT) Fail() { s.failed = true }
+
+func (s *scratchT) FailNow() { s.failed = true }
+
+func (s *scratchT) Failed() bool { return s.failed }
+
+func (s *scratchT) reset() {
+	s.logs = nil
+	s.failed = false
+}
+
+func (s *scratchT) lastLog() string {
+	if len(s.logs) == 0 {
+		return ""
+	}
+	return s.logs[len(s.logs)-1]
+}
+
+// Eventually asserts that condition returns true before timeout elapses, sampling it every
+// interval. condition is given a scratch testing.TB, scoped to that single attempt, so it can
+// run other assertions against it instead of returning a bare bool. Eventually reports an
+// error if the deadline is reached first.
+func Eventually(t testing.TB, condition func(t testing.TB) bool, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	file, line := callerInfo()
+	scratch := newScratchT()
+	start := time.Now()
+	deadline := start.Add(timeout)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	attempts := 0
+	for {
+		attempts++
+		scratch.reset()
+		if condition(scratch) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+		<-ticker.C
+	}
+	report(t, Failure{
+		Assertion: "Condition was not met",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Extra: [][2]string{
+			{"Timeout", timeout.String()},
+			{"Elapsed", time.Since(start).String()},
+			{"Attempts", fmt.Sprintf("%d", attempts)},
+		},
+	})
+	return false
+}
+
+// EventuallyEqual asserts that actual() returns a value equal to expected before timeout
+// elapses, sampling it every interval. actual is given a scratch testing.TB, scoped to that
+// single attempt, so it can run other assertions against it. On timeout, the diff from the
+// last observed value is rendered through the same formatter as Equal.
+func EventuallyEqual[T any](t testing.TB, actual func(t testing.TB) T, expected T, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	file, line := callerInfo()
+	scratch := newScratchT()
+	start := time.Now()
+	deadline := start.Add(timeout)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	attempts := 0
+	for {
+		attempts++
+		scratch.reset()
+		if Equal(scratch, actual(scratch), expected) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+		<-ticker.C
+	}
+	report(t, Failure{
+		Assertion: "Condition was not met",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Extra: [][2]string{
+			{"Timeout", timeout.String()},
+			{"Elapsed", time.Since(start).String()},
+			{"Attempts", fmt.Sprintf("%d", attempts)},
+			{"Diff", strings.TrimSpace(scratch.lastLog())},
+		},
+	})
+	return false
+}
+
+// Never asserts that condition returns false for the entire duration, sampling it every
+// interval. condition is given a scratch testing.TB, scoped to that single attempt, so it can
+// run other assertions against it. Never reports an error as soon as condition returns true.
+func Never(t testing.TB, condition func(t testing.TB) bool, duration, interval time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	file, line := callerInfo()
+	scratch := newScratchT()
+	start := time.Now()
+	deadline := start.Add(duration)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	attempts := 0
+	for time.Now().Before(deadline) {
+		attempts++
+		scratch.reset()
+		if condition(scratch) {
+			report(t, Failure{
+				Assertion: "Condition was met",
+				File:      file,
+				Line:      line,
+				Message:   messageFromMsgAndArgs(msgAndArgs...),
+				Extra: [][2]string{
+					{"Duration", duration.String()},
+					{"Elapsed", time.Since(start).String()},
+					{"Attempts", fmt.Sprintf("%d", attempts)},
+				},
+			})
+			return false
+		}
+		<-ticker.C
+	}
+	return true
+}
+
+// WithinDuration asserts that actual is within delta of expected.
+func WithinDuration(t testing.TB, actual, expected time.Time, delta time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	diff := actual.Sub(expected)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff <= delta {
+		return true
+	}
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion: "Times not within duration",
+		File:      file,
+		Line:      line,
+		Message:   messageFromMsgAndArgs(msgAndArgs...),
+		Expected:  expected.String(),
+		Actual:    actual.String(),
+		Extra: [][2]string{
+			{"Delta", delta.String()},
+			{"Diff", diff.String()},
+		},
+	})
+	return false
+}
@@ -0,0 +1,91 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventually(t *testing.T) {
+	t.Run("succeeds before timeout", func(t *testing.T) {
+		count := 0
+		mockT := NewMockT()
+		Eventually(mockT, func(t testing.TB) bool {
+			count++
+			return count >= 3
+		}, 200*time.Millisecond, time.Millisecond)
+		mockT.AssertFailed(t, "")
+	})
+
+	t.Run("fails on timeout", func(t *testing.T) {
+		mockT := NewMockT()
+		Eventually(mockT, func(t testing.TB) bool { return false }, 20*time.Millisecond, time.Millisecond)
+		if !mockT.Failed() {
+			t.Fatal("should fail")
+		}
+		if !strings.Contains(mockT.Logs(), "Condition was not met") {
+			t.Fatalf("unexpected log: %s", mockT.Logs())
+		}
+	})
+
+	t.Run("condition can assert against the scratch TB", func(t *testing.T) {
+		mockT := NewMockT()
+		Eventually(mockT, func(scratch testing.TB) bool {
+			return Equal(scratch, 1, 1)
+		}, 20*time.Millisecond, time.Millisecond)
+		mockT.AssertFailed(t, "")
+	})
+}
+
+func TestEventuallyEqual(t *testing.T) {
+	t.Run("succeeds before timeout", func(t *testing.T) {
+		count := 0
+		mockT := NewMockT()
+		EventuallyEqual(mockT, func(t testing.TB) int {
+			count++
+			return count
+		}, 3, 200*time.Millisecond, time.Millisecond)
+		mockT.AssertFailed(t, "")
+	})
+
+	t.Run("fails on timeout with last diff", func(t *testing.T) {
+		mockT := NewMockT()
+		EventuallyEqual(mockT, func(t testing.TB) int { return 1 }, 2, 20*time.Millisecond, time.Millisecond)
+		if !mockT.Failed() {
+			t.Fatal("should fail")
+		}
+		if !strings.Contains(mockT.Logs(), "Diff:") {
+			t.Fatalf("unexpected log: %s", mockT.Logs())
+		}
+	})
+}
+
+func TestNever(t *testing.T) {
+	t.Run("succeeds when condition never true", func(t *testing.T) {
+		mockT := NewMockT()
+		Never(mockT, func(t testing.TB) bool { return false }, 20*time.Millisecond, time.Millisecond)
+		mockT.AssertFailed(t, "")
+	})
+
+	t.Run("fails when condition becomes true", func(t *testing.T) {
+		mockT := NewMockT()
+		Never(mockT, func(t testing.TB) bool { return true }, 20*time.Millisecond, time.Millisecond)
+		if !mockT.Failed() {
+			t.Fatal("should fail")
+		}
+	})
+}
+
+func TestWithinDuration(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockT := NewMockT()
+	WithinDuration(mockT, now.Add(time.Second), now, 2*time.Second)
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	WithinDuration(mockT, now.Add(10*time.Second), now, 2*time.Second)
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
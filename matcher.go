@@ -0,0 +1,331 @@
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Matcher describes a composable assertion that can be evaluated against a value of type T.
+// Concrete matchers are built with the constructor functions below (Equals, HasLength, AllOf, ...)
+// and combined to express richer expectations than a single Equal call can.
+type Matcher[T any] interface {
+	// Match reports whether v satisfies the matcher. When it doesn't, the returned string
+	// describes what was actually observed, to be rendered on the "But:" line.
+	Match(v T) (bool, string)
+	// Describe returns a human-readable description of what the matcher expects, rendered on
+	// the "Expected:" line.
+	Describe() string
+}
+
+// That asserts that actual satisfies matcher and reports an error if it does not.
+func That[T any](t testing.TB, actual T, matcher Matcher[T], msgAndArgs ...any) bool {
+	t.Helper()
+	ok, mismatch := matcher.Match(actual)
+	if ok {
+		return true
+	}
+	file, line := callerInfo()
+	report(t, Failure{
+		Assertion:   "Assertion failed",
+		File:        file,
+		Line:        line,
+		Message:     messageFromMsgAndArgs(msgAndArgs...),
+		Expected:    matcher.Describe(),
+		Actual:      mismatch,
+		ActualLabel: "But",
+	})
+	return false
+}
+
+type equalsMatcher[T comparable] struct {
+	expected T
+}
+
+func (m equalsMatcher[T]) Match(v T) (bool, string) {
+	if v == m.expected {
+		return true, ""
+	}
+	return false, fmt.Sprintf("was %#v", v)
+}
+
+func (m equalsMatcher[T]) Describe() string {
+	return fmt.Sprintf("equal to %#v", m.expected)
+}
+
+// Equals matches a value that is == to expected.
+func Equals[T comparable](expected T) Matcher[T] {
+	return equalsMatcher[T]{expected: expected}
+}
+
+type deepEqualsMatcher[T any] struct {
+	expected T
+}
+
+func (m deepEqualsMatcher[T]) Match(v T) (bool, string) {
+	if reflect.DeepEqual(m.expected, v) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("was %#v", v)
+}
+
+func (m deepEqualsMatcher[T]) Describe() string {
+	return fmt.Sprintf("deeply equal to %#v", m.expected)
+}
+
+// DeepEquals matches a value that is reflect.DeepEqual to expected, for types that don't
+// support ==, such as slices and maps.
+func DeepEquals[T any](expected T) Matcher[T] {
+	return deepEqualsMatcher[T]{expected: expected}
+}
+
+type lessThanMatcher[T cmp.Ordered] struct {
+	bound T
+}
+
+func (m lessThanMatcher[T]) Match(v T) (bool, string) {
+	if v < m.bound {
+		return true, ""
+	}
+	return false, fmt.Sprintf("was %v", v)
+}
+
+func (m lessThanMatcher[T]) Describe() string {
+	return fmt.Sprintf("less than %v", m.bound)
+}
+
+// LessThan matches a value strictly less than bound.
+func LessThan[T cmp.Ordered](bound T) Matcher[T] {
+	return lessThanMatcher[T]{bound: bound}
+}
+
+type greaterThanMatcher[T cmp.Ordered] struct {
+	bound T
+}
+
+func (m greaterThanMatcher[T]) Match(v T) (bool, string) {
+	if v > m.bound {
+		return true, ""
+	}
+	return false, fmt.Sprintf("was %v", v)
+}
+
+func (m greaterThanMatcher[T]) Describe() string {
+	return fmt.Sprintf("greater than %v", m.bound)
+}
+
+// GreaterThan matches a value strictly greater than bound.
+func GreaterThan[T cmp.Ordered](bound T) Matcher[T] {
+	return greaterThanMatcher[T]{bound: bound}
+}
+
+type hasLengthMatcher[T any] struct {
+	n int
+}
+
+func (m hasLengthMatcher[T]) Match(v T) (bool, string) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		if rv.Len() == m.n {
+			return true, ""
+		}
+		return false, fmt.Sprintf("had length %d", rv.Len())
+	default:
+		return false, fmt.Sprintf("has no length (%T)", v)
+	}
+}
+
+func (m hasLengthMatcher[T]) Describe() string {
+	return fmt.Sprintf("has length %d", m.n)
+}
+
+// HasLength matches a string, slice, array, map or channel with exactly n elements.
+func HasLength[T any](n int) Matcher[T] {
+	return hasLengthMatcher[T]{n: n}
+}
+
+type stringContainingMatcher struct {
+	substr string
+}
+
+func (m stringContainingMatcher) Match(v string) (bool, string) {
+	if strings.Contains(v, m.substr) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("was %q", v)
+}
+
+func (m stringContainingMatcher) Describe() string {
+	return fmt.Sprintf("a string containing %q", m.substr)
+}
+
+// StringContaining matches a string that contains substr.
+func StringContaining(substr string) Matcher[string] {
+	return stringContainingMatcher{substr: substr}
+}
+
+type mapContainingKeyMatcher[K comparable, V any] struct {
+	key K
+}
+
+func (m mapContainingKeyMatcher[K, V]) Match(v map[K]V) (bool, string) {
+	if _, ok := v[m.key]; ok {
+		return true, ""
+	}
+	return false, fmt.Sprintf("map had no key %#v", m.key)
+}
+
+func (m mapContainingKeyMatcher[K, V]) Describe() string {
+	return fmt.Sprintf("a map containing key %#v", m.key)
+}
+
+// MapContainingKey matches a map that has key among its keys.
+func MapContainingKey[K comparable, V any](key K) Matcher[map[K]V] {
+	return mapContainingKeyMatcher[K, V]{key: key}
+}
+
+type mapContainingValueMatcher[K comparable, V any] struct {
+	value V
+}
+
+func (m mapContainingValueMatcher[K, V]) Match(v map[K]V) (bool, string) {
+	for _, actual := range v {
+		if reflect.DeepEqual(actual, m.value) {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("map had no value %#v", m.value)
+}
+
+func (m mapContainingValueMatcher[K, V]) Describe() string {
+	return fmt.Sprintf("a map containing value %#v", m.value)
+}
+
+// MapContainingValue matches a map that has value among its values.
+func MapContainingValue[K comparable, V any](value V) Matcher[map[K]V] {
+	return mapContainingValueMatcher[K, V]{value: value}
+}
+
+type sliceContainingMatcher[T any] struct {
+	element T
+}
+
+func (m sliceContainingMatcher[T]) Match(v []T) (bool, string) {
+	for _, el := range v {
+		if reflect.DeepEqual(el, m.element) {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("slice had no element %#v", m.element)
+}
+
+func (m sliceContainingMatcher[T]) Describe() string {
+	return fmt.Sprintf("a slice containing %#v", m.element)
+}
+
+// SliceContaining matches a slice that has element among its elements.
+func SliceContaining[T any](element T) Matcher[[]T] {
+	return sliceContainingMatcher[T]{element: element}
+}
+
+type allOfMatcher[T any] struct {
+	matchers []Matcher[T]
+}
+
+func (m allOfMatcher[T]) Match(v T) (bool, string) {
+	for _, matcher := range m.matchers {
+		if ok, mismatch := matcher.Match(v); !ok {
+			return false, fmt.Sprintf("%s (%s)", mismatch, matcher.Describe())
+		}
+	}
+	return true, ""
+}
+
+func (m allOfMatcher[T]) Describe() string {
+	descriptions := make([]string, len(m.matchers))
+	for i, matcher := range m.matchers {
+		descriptions[i] = matcher.Describe()
+	}
+	return strings.Join(descriptions, " and ")
+}
+
+// AllOf matches a value that satisfies every one of matchers.
+func AllOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return allOfMatcher[T]{matchers: matchers}
+}
+
+type anyOfMatcher[T any] struct {
+	matchers []Matcher[T]
+}
+
+func (m anyOfMatcher[T]) Match(v T) (bool, string) {
+	var mismatches []string
+	for _, matcher := range m.matchers {
+		if ok, mismatch := matcher.Match(v); ok {
+			return true, ""
+		} else {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+	return false, strings.Join(mismatches, "; ")
+}
+
+func (m anyOfMatcher[T]) Describe() string {
+	descriptions := make([]string, len(m.matchers))
+	for i, matcher := range m.matchers {
+		descriptions[i] = matcher.Describe()
+	}
+	return strings.Join(descriptions, " or ")
+}
+
+// AnyOf matches a value that satisfies at least one of matchers.
+func AnyOf[T any](matchers ...Matcher[T]) Matcher[T] {
+	return anyOfMatcher[T]{matchers: matchers}
+}
+
+type notMatcher[T any] struct {
+	matcher Matcher[T]
+}
+
+func (m notMatcher[T]) Match(v T) (bool, string) {
+	if ok, _ := m.matcher.Match(v); ok {
+		return false, fmt.Sprintf("was %#v", v)
+	}
+	return true, ""
+}
+
+func (m notMatcher[T]) Describe() string {
+	return "not " + m.matcher.Describe()
+}
+
+// Not inverts matcher.
+func Not[T any](matcher Matcher[T]) Matcher[T] {
+	return notMatcher[T]{matcher: matcher}
+}
+
+type nilMatcher[T any] struct{}
+
+func (m nilMatcher[T]) Match(v T) (bool, string) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		if rv.IsNil() {
+			return true, ""
+		}
+	case reflect.Invalid:
+		return true, ""
+	}
+	return false, fmt.Sprintf("was %#v", v)
+}
+
+func (m nilMatcher[T]) Describe() string {
+	return "nil"
+}
+
+// NilMatcher matches a nil pointer, slice, map, channel, func, interface or unsafe pointer.
+func NilMatcher[T any]() Matcher[T] {
+	return nilMatcher[T]{}
+}
@@ -0,0 +1,160 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestThat(t *testing.T) {
+	tests := []struct {
+		name        string
+		actual      int
+		matcher     Matcher[int]
+		expectedMsg string
+	}{
+		{"equals_match", 1, Equals(1), ""},
+		{"equals_mismatch", 1, Equals(2), `
+Error:    Assertion failed
+Expected: equal to 2
+But:      was 1`},
+		{"less_than_match", 1, LessThan(2), ""},
+		{"less_than_mismatch", 2, LessThan(2), `
+Error:    Assertion failed
+Expected: less than 2
+But:      was 2`},
+		{"greater_than_match", 2, GreaterThan(1), ""},
+		{"not_match", 1, Not(Equals(2)), ""},
+		{"not_mismatch", 1, Not(Equals(1)), `
+Error:    Assertion failed
+Expected: not equal to 1
+But:      was 1`},
+		{"all_of_match", 1, AllOf(GreaterThan(0), LessThan(2)), ""},
+		{"all_of_mismatch", 3, AllOf(GreaterThan(0), LessThan(2)), `
+Error:    Assertion failed
+Expected: greater than 0 and less than 2
+But:      was 3 (less than 2)`},
+		{"any_of_match", 3, AnyOf(LessThan(2), GreaterThan(2)), ""},
+		{"any_of_mismatch", 2, AnyOf(LessThan(2), GreaterThan(2)), `
+Error:    Assertion failed
+Expected: less than 2 or greater than 2
+But:      was 2; was 2`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := NewMockT()
+			That(mockT, tt.actual, tt.matcher)
+			mockT.AssertFailed(t, tt.expectedMsg)
+		})
+	}
+}
+
+func TestThat_CustomMessage(t *testing.T) {
+	mockT := NewMockT()
+	That(mockT, 1, Equals(2), "custom message")
+	mockT.AssertFailed(t, `
+Error:    Assertion failed
+Message:  custom message
+Expected: equal to 2
+But:      was 1`)
+}
+
+func TestDeepEquals(t *testing.T) {
+	mockT := NewMockT()
+	That(mockT, []int{1, 2}, DeepEquals([]int{1, 2}))
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, []int{1, 2}, DeepEquals([]int{1, 3}))
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestHasLength(t *testing.T) {
+	mockT := NewMockT()
+	That(mockT, []int{1, 2, 3}, HasLength[[]int](3))
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, "abc", HasLength[string](2))
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestStringContaining(t *testing.T) {
+	mockT := NewMockT()
+	That(mockT, "hello world", StringContaining("world"))
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, "hello world", StringContaining("bye"))
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestMapContainingKeyAndValue(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2}
+
+	mockT := NewMockT()
+	That(mockT, m, MapContainingKey[string, int]("one"))
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, m, MapContainingKey[string, int]("three"))
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+
+	mockT = NewMockT()
+	That(mockT, m, MapContainingValue[string, int](2))
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, m, MapContainingValue[string, int](3))
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestSliceContaining(t *testing.T) {
+	mockT := NewMockT()
+	That(mockT, []string{"a", "b"}, SliceContaining("b"))
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, []string{"a", "b"}, SliceContaining("c"))
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestNilMatcher(t *testing.T) {
+	mockT := NewMockT()
+	That(mockT, nilSlice, NilMatcher[[]string]())
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, emptySlice, NilMatcher[[]string]())
+	if !mockT.Failed() {
+		t.Fatal("should fail")
+	}
+}
+
+func TestThat_Integration(t *testing.T) {
+	type user struct {
+		Name string
+	}
+	users := []user{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}}
+	mockT := NewMockT()
+	That(mockT, users, AllOf(HasLength[[]user](3), SliceContaining(user{Name: "Bob"})))
+	mockT.AssertFailed(t, "")
+
+	mockT = NewMockT()
+	That(mockT, users, SliceContaining(user{Name: "Dave"}))
+	if !mockT.Failed() || !strings.Contains(mockT.Logs(), "Dave") {
+		t.Fatal("expected failure mentioning missing element")
+	}
+}
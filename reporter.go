@@ -0,0 +1,131 @@
+package assert
+
+import (
+	"encoding/json"
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// Failure describes a single assertion failure, independent of how it's rendered. Every
+// assertion in the package builds one and routes it through report, so a single Reporter
+// (e.g. the JSON one enabled by ASSERT_OUTPUT=json) sees every failure, not just Equal's.
+type Failure struct {
+	Assertion string `json:"assertion"`
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	Expected  string `json:"expected,omitempty"`
+	Actual    string `json:"actual,omitempty"`
+	Diff      string `json:"diff,omitempty"`
+	Message   string `json:"message,omitempty"`
+	// Extra carries assertion-specific rows (e.g. Eventually's Timeout/Elapsed/Attempts,
+	// ErrorIs's Chain) that don't fit the common fields above. Rendered, in order,
+	// after them by textReporter; included as-is in jsonReporter's output.
+	Extra [][2]string `json:"extra,omitempty"`
+	// ExpectedLabel/ActualLabel override the "Expected"/"Actual" row labels used by
+	// textReporter, for assertions whose fields play that role but read better under a
+	// different name (e.g. NotEqual's "Value", That's "But", ErrorIs's "Target"). Ignored by
+	// jsonReporter, and not part of the JSON wire format.
+	ExpectedLabel string `json:"-"`
+	ActualLabel   string `json:"-"`
+}
+
+// Reporter renders a Failure. The default reporter reproduces the existing colored,
+// human-readable block; SetReporter can swap in e.g. a JSON reporter for CI consumption.
+type Reporter interface {
+	Report(t testing.TB, f Failure)
+}
+
+var (
+	reporterMu sync.RWMutex
+	reporter   Reporter = textReporter{}
+)
+
+// SetReporter overrides the Reporter used to render assertion failures. Like
+// SetDefaultOptions, call it before any parallel (t.Parallel) tests start; it's safe to call
+// concurrently with assertions, but a goroutine already mid-assertion may still observe the
+// previous reporter.
+func SetReporter(r Reporter) {
+	reporterMu.Lock()
+	reporter = r
+	reporterMu.Unlock()
+}
+
+func getReporter() Reporter {
+	reporterMu.RLock()
+	defer reporterMu.RUnlock()
+	return reporter
+}
+
+func init() {
+	if os.Getenv("ASSERT_OUTPUT") == "json" {
+		reporter = jsonReporter{}
+	}
+}
+
+// report renders f through the configured Reporter and fails t. f.File/f.Line are normally
+// populated by the caller via callerInfo, taken at the public assertion entry point so they
+// point at the user's call site rather than somewhere inside this package.
+func report(t testing.TB, f Failure) {
+	t.Helper()
+	getReporter().Report(t, f)
+	t.Fail()
+}
+
+// callerInfo returns the file and line of its caller's caller, i.e. it's meant to be called
+// directly from a public assertion function (Equal, NotEqual, ...) to capture where that
+// function itself was called from.
+func callerInfo() (string, int) {
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		return "", 0
+	}
+	return file, line
+}
+
+type textReporter struct{}
+
+func (textReporter) Report(t testing.TB, f Failure) {
+	t.Helper()
+	result := [][2]string{
+		{"Error", colorize(colorRed, f.Assertion)},
+	}
+	if f.Message != "" {
+		result = append(result, [2]string{"Message", colorize(colorYellow, f.Message)})
+	}
+	if f.Expected != "" {
+		label := f.ExpectedLabel
+		if label == "" {
+			label = "Expected"
+		}
+		result = append(result, [2]string{label, colorize(colorGreen, f.Expected)})
+	}
+	if f.Actual != "" {
+		label := f.ActualLabel
+		if label == "" {
+			label = "Actual"
+		}
+		result = append(result, [2]string{label, colorize(colorRed, f.Actual)})
+	}
+	if f.Diff != "" {
+		result = append(result, [2]string{"Diff", f.Diff})
+	}
+	result = append(result, f.Extra...)
+	t.Log(sprintList(result))
+}
+
+// jsonReporter renders each Failure as a single JSON line via t.Log, so `go test -json`
+// consumers and CI annotators (GitHub Actions problem matchers, Buildkite test analytics)
+// can parse failures without scraping ANSI-colored text.
+type jsonReporter struct{}
+
+func (jsonReporter) Report(t testing.TB, f Failure) {
+	t.Helper()
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Log(err.Error())
+		return
+	}
+	t.Log(string(b))
+}
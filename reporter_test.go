@@ -0,0 +1,42 @@
+package assert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSetReporter_JSON(t *testing.T) {
+	t.Cleanup(func() { SetReporter(textReporter{}) })
+	SetReporter(jsonReporter{})
+
+	mockT := NewMockT()
+	Equal(mockT, 1, 2)
+
+	var f Failure
+	if err := json.Unmarshal([]byte(mockT.Logs()), &f); err != nil {
+		t.Fatalf("expected valid JSON log, got %q: %v", mockT.Logs(), err)
+	}
+	Equal(t, f.Assertion, "Not equal")
+	Equal(t, f.Expected, "2")
+	Equal(t, f.Actual, "1")
+}
+
+func TestCallerInfo(t *testing.T) {
+	mockT := NewMockT()
+	SetReporter(jsonReporter{})
+	t.Cleanup(func() { SetReporter(textReporter{}) })
+
+	Equal(mockT, 1, 2) // the reported file and line are checked below
+
+	var f Failure
+	if err := json.Unmarshal([]byte(mockT.Logs()), &f); err != nil {
+		t.Fatalf("expected valid JSON log: %v", err)
+	}
+	if !strings.HasSuffix(f.File, "reporter_test.go") {
+		t.Fatalf("expected file to be this test file, got %q", f.File)
+	}
+	if f.Line == 0 {
+		t.Fatal("expected a non-zero line number")
+	}
+}